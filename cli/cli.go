@@ -9,8 +9,16 @@ import (
 	"strings"
 	"sync"
 
+	"go-gpt-cli/internal/audio"
 	"go-gpt-cli/internal/client"
+	"go-gpt-cli/internal/client/anthropic"
+	"go-gpt-cli/internal/client/azureopenai"
+	"go-gpt-cli/internal/client/ollama"
+	"go-gpt-cli/internal/client/openairealtime"
 	"go-gpt-cli/internal/config"
+	"go-gpt-cli/internal/logging"
+	"go-gpt-cli/internal/session"
+	"go-gpt-cli/internal/tools"
 
 	"github.com/spf13/cobra"
 )
@@ -25,7 +33,16 @@ var chatCmd = &cobra.Command{
 	Run:   runConcurrentChat,
 }
 
+var voiceMode bool
+var sessionID string
+var toolsEnabled bool
+var providerFlag string
+
 func init() {
+	chatCmd.Flags().BoolVar(&voiceMode, "voice", false, "Capture microphone input and request spoken responses")
+	chatCmd.Flags().StringVar(&sessionID, "session", "", "Resume a saved session by ID (see 'gpt-cli session list')")
+	chatCmd.Flags().BoolVar(&toolsEnabled, "tools", false, "Enable the built-in shell_exec and http_get tools")
+	chatCmd.Flags().StringVar(&providerFlag, "provider", "", "Backend to chat with: openai-realtime, azure-openai, anthropic, ollama (default from $PROVIDER or openai-realtime)")
 	rootCmd.AddCommand(chatCmd)
 }
 
@@ -38,6 +55,15 @@ func main() {
 // =====================================
 // Concurrent Streaming Implementation
 // =====================================
+//
+// handleDisplay/handleAIStreaming below are openairealtime-specific and
+// deliberately not unified with handleGenericDisplay (generic provider chat,
+// further down this file): openairealtime.Client's state machine exposes
+// interrupt/cancel, tool-running, and voice states that the generic
+// client.Client interface has no room for, and that this UI loop reacts to
+// directly. Collapsing both onto the generic interface would mean losing
+// that state-aware switch (or growing client.Client to describe it), so the
+// realtime backend keeps its own richer loop instead.
 
 // handleUserInput reads keyboard input continuously in a separate goroutine
 // userInput: send-only channel to send user messages
@@ -69,7 +95,7 @@ func handleUserInput(userInput chan<- string, ctx context.Context) {
 // client: WebSocket client for reading AI responses
 // aiChunks: send-only channel to forward AI text chunks
 // ctx: context to know when to stop
-func handleAIStreaming(client *client.Client, aiChunks chan<- string, ctx context.Context) {
+func handleAIStreaming(client *openairealtime.Client, aiChunks chan<- string, ctx context.Context) {
 	// Note: aiChunks will be closed by main goroutine, not here
 
 	// Start streaming - this will block until done or error
@@ -79,14 +105,14 @@ func handleAIStreaming(client *client.Client, aiChunks chan<- string, ctx contex
 	// The main goroutine will handle cleanup
 }
 
-func handleDisplay(userInput <-chan string, aiChunks <-chan string, wsClient *client.Client, cancel context.CancelFunc) {
+func handleDisplay(userInput <-chan string, aiChunks <-chan string, wsClient *openairealtime.Client, logger logging.Logger, cancel context.CancelFunc) {
 	// Clean state management without local variables
 	for {
 		select {
 		case input, ok := <-userInput:
 			// Check if channel was closed
 			if !ok {
-				fmt.Println("\n📪 User input channel closed")
+				logger.Info("User input channel closed")
 				cancel()
 				return
 			}
@@ -100,29 +126,32 @@ func handleDisplay(userInput <-chan string, aiChunks <-chan string, wsClient *cl
 
 			// ✅ Smart interrupt handling with state awareness
 			currentState := wsClient.GetState()
-			fmt.Printf("\n🔍 Current state: %s\n", currentState)
+			logger.Debug("Current state", logging.F("state", currentState.String()))
 
 			switch currentState {
-			case client.StateResponding:
-				fmt.Println("🛑 AI is responding, interrupting...")
+			case openairealtime.StateResponding:
+				logger.Info("AI is responding, interrupting")
 				wsClient.CancelResponse()
 				// ✅ No waiting - send message immediately!
 
-			case client.StateCancelling:
-				fmt.Println("⏳ Cancelling in progress, sending message anyway...")
+			case openairealtime.StateCancelling:
+				logger.Info("Cancelling in progress, sending message anyway")
 				// ✅ No waiting - let the user send!
 
-			case client.StateIdle:
-				fmt.Println("✅ Ready to send message")
+			case openairealtime.StateIdle:
+				logger.Debug("Ready to send message")
+
+			case openairealtime.StateToolRunning:
+				logger.Info("A tool call is still running, sending message anyway")
 
-			case client.StateResponded:
+			case openairealtime.StateResponded:
 
 			}
 
 			// Send message to AI
-			fmt.Printf("📤 Sending: %s\n", input)
+			logger.Info("Sending message", logging.F("input", input))
 			if err := wsClient.SendMessageAsync(input); err != nil {
-				fmt.Printf("❌ Send error: %v\n", err)
+				logger.Error("Send error", logging.F("error", err.Error()))
 				continue
 			}
 
@@ -132,44 +161,188 @@ func handleDisplay(userInput <-chan string, aiChunks <-chan string, wsClient *cl
 		case chunk, ok := <-aiChunks:
 			// Check if channel was closed
 			if !ok {
-				fmt.Println("\n📪 AI chunks channel closed")
+				logger.Info("AI chunks channel closed")
 				cancel()
 				return
 			}
 
 			// ✅ Display chunks if we're in responding or responded state
 			switch wsClient.GetState() {
-			case client.StateResponding, client.StateResponded:
+			case openairealtime.StateResponding, openairealtime.StateResponded:
 				fmt.Print(chunk)
-				if wsClient.GetState() == client.StateResponded && len(chunk) == 0 {
-					//wsClient.SetState(client.StateIdle)
+				if wsClient.GetState() == openairealtime.StateResponded && len(chunk) == 0 {
+					//wsClient.SetState(openairealtime.StateIdle)
 				}
 			default:
 				// Got chunk but not in active display state - might be leftover
-				fmt.Printf("\n🔧 Received chunk in %s state (ignored): %q\n", wsClient.GetState(), chunk)
+				logger.Warn("Received chunk in unexpected state (ignored)",
+					logging.F("state", wsClient.GetState().String()), logging.F("chunk", chunk))
+			}
+		}
+	}
+}
+
+// handleMicInput captures microphone audio and streams it to the client as
+// input_audio_buffer.append events until ctx is cancelled.
+func handleMicInput(recorder audio.Recorder, wsClient *openairealtime.Client, logger logging.Logger, ctx context.Context) {
+	frames, err := recorder.Start()
+	if err != nil {
+		logger.Error("Microphone error", logging.F("error", err.Error()))
+		return
+	}
+	defer recorder.Stop()
+
+	for {
+		select {
+		case frame, ok := <-frames:
+			if !ok {
+				return
 			}
+			if err := wsClient.AppendInputAudio(frame); err != nil {
+				logger.Error("Failed to append input audio", logging.F("error", err.Error()))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// handleAIAudio drains decoded response.audio.delta PCM16 frames so the
+// channel never blocks streaming. The frames are discarded; nothing plays
+// them back.
+func handleAIAudio(audioChunks <-chan []byte, ctx context.Context) {
+	for {
+		select {
+		case _, ok := <-audioChunks:
+			if !ok {
+				return
+			}
+		case <-ctx.Done():
+			return
 		}
 	}
 }
 
+// newLogger builds the Logger used for the whole chat session: console by
+// default, or one JSON object per line when LOG_FORMAT=json (e.g. piping
+// into a log aggregator).
+func newLogger(level logging.Level) logging.Logger {
+	if os.Getenv("LOG_FORMAT") == "json" {
+		return logging.NewJSONStdout(level)
+	}
+	return logging.NewConsole(level)
+}
+
 func runConcurrentChat(cmd *cobra.Command, args []string) {
+	logger := newLogger(logging.InfoLevel)
+
 	cfg, err := config.Load()
 	if err != nil {
-		log.Fatal("Config error:", err)
+		logger.Error("Config error", logging.F("error", err.Error()))
+		os.Exit(1)
+	}
+
+	logLevel := logging.InfoLevel
+	if cfg.Debug {
+		logLevel = logging.DebugLevel
+	}
+	logger = newLogger(logLevel)
+
+	if providerFlag != "" {
+		cfg.Provider = providerFlag
+	}
+
+	switch cfg.Provider {
+	case "anthropic":
+		runGenericChat(anthropic.New(cfg.AnthropicAPIKey, cfg.AnthropicModel, anthropic.WithLogger(logger)), logger)
+		return
+	case "ollama":
+		ollamaOpts := []ollama.Option{ollama.WithLogger(logger)}
+		if cfg.OllamaBaseURL != "" {
+			ollamaOpts = append(ollamaOpts, ollama.WithBaseURL(cfg.OllamaBaseURL))
+		}
+		runGenericChat(ollama.New(cfg.OllamaModel, ollamaOpts...), logger)
+		return
+	case "", "openai-realtime":
+		if cfg.OpenAIAPIKey == "" {
+			logger.Error("OPENAI_API_KEY is missing in .env file")
+			os.Exit(1)
+		}
+	case "azure-openai":
+		// Falls through to the rich realtime path below, which speaks the
+		// same WebSocket protocol as openai-realtime; azureopenai.New
+		// validates its own required fields.
+	default:
+		logger.Error("Unknown provider", logging.F("provider", cfg.Provider))
+		os.Exit(1)
+	}
+
+	clientOpts := []openairealtime.Option{openairealtime.WithLogger(logger)}
+	if sessionID != "" {
+		store, err := session.NewFileStore(cfg.SessionDir)
+		if err != nil {
+			logger.Error("Session store error", logging.F("error", err.Error()))
+			os.Exit(1)
+		}
+		clientOpts = append(clientOpts, openairealtime.WithSessionStore(store, sessionID))
+	}
+	if toolsEnabled {
+		registry := tools.NewRegistry()
+		registry.Register(tools.NewShellTool("ls", "pwd", "date", "whoami"))
+		registry.Register(tools.NewHTTPGetTool())
+		clientOpts = append(clientOpts, openairealtime.WithTools(registry))
+	}
+	clientOpts = append(clientOpts,
+		openairealtime.WithReconnect(true),
+		openairealtime.WithOnReconnect(func() { logger.Info("Reconnected") }),
+	)
+
+	var wsClient *openairealtime.Client
+	if cfg.Provider == "azure-openai" {
+		var err error
+		wsClient, err = azureopenai.New(azureopenai.Config{
+			Endpoint:   cfg.AzureOpenAIEndpoint,
+			Deployment: cfg.AzureOpenAIDeployment,
+			APIVersion: cfg.AzureOpenAIAPIVersion,
+			APIKey:     cfg.AzureOpenAIAPIKey,
+		}, clientOpts...)
+		if err != nil {
+			logger.Error("Azure OpenAI config error", logging.F("error", err.Error()))
+			os.Exit(1)
+		}
+	} else {
+		wsClient = openairealtime.New(cfg.OpenAIAPIKey, cfg.WebSocketURL, cfg.Model, clientOpts...)
+	}
+
+	if voiceMode {
+		wsClient.SetVoice(cfg.Voice)
+		wsClient.EnableVAD(openairealtime.VADConfig{
+			Enabled:           cfg.VADEnabled,
+			Threshold:         cfg.VADThreshold,
+			PrefixPaddingMs:   cfg.VADPrefixPaddingMs,
+			SilenceDurationMs: cfg.VADSilenceDurationMs,
+		})
 	}
 
-	wsClient := client.New(cfg.OpenAIAPIKey, cfg.WebSocketURL, cfg.Model)
 	if err := wsClient.Connect(); err != nil {
-		log.Fatal("Connection error:", err)
+		logger.Error("Connection error", logging.F("error", err.Error()))
+		os.Exit(1)
 	}
 	defer wsClient.Close()
 
 	// Create channels for communication between goroutines
 	userInput := make(chan string, 5) // Buffer for user messages
 	aiChunks := make(chan string, 50) // Buffer for AI response chunks
+	aiAudio := make(chan []byte, 50)  // Buffer for AI audio response chunks
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	if voiceMode {
+		wsClient.SetAudioOutput(aiAudio)
+		go handleMicInput(audio.NewRecorder(cfg.SampleRate, 0), wsClient, logger, ctx)
+		go handleAIAudio(aiAudio, ctx)
+	}
+
 	fmt.Println("🚀 Starting concurrent chat...")
 	fmt.Println("🤖 GPT Concurrent Chat")
 	fmt.Println("Type 'exit' or 'quit' to end")
@@ -190,7 +363,7 @@ func runConcurrentChat(cmd *cobra.Command, args []string) {
 	}()
 	go func() {
 		defer wg.Done()
-		handleDisplay(userInput, aiChunks, wsClient, cancel)
+		handleDisplay(userInput, aiChunks, wsClient, logger, cancel)
 	}()
 
 	// Wait for context to be done (e.g., when user exits)
@@ -205,3 +378,109 @@ func runConcurrentChat(cmd *cobra.Command, args []string) {
 
 	fmt.Println("🔚 Concurrent chat ended")
 }
+
+// =====================================
+// Generic provider chat (non-realtime backends)
+// =====================================
+
+// handleGenericDisplay mirrors handleDisplay for providers that only
+// implement the generic client.Client surface (no interrupt/cancel, no
+// state-aware switch beyond start/stop).
+//
+// Unlike openairealtime.Client, these backends have no persistent
+// connection to read from in the background: StartStreaming issues one
+// request for the turns sent so far and returns once that reply completes.
+// So each successful SendMessageAsync spawns its own StartStreaming call
+// instead of relying on a single long-lived streaming goroutine, which
+// would see no pending turn yet and exit before the user ever types
+// anything.
+func handleGenericDisplay(userInput <-chan string, aiChunks chan client.Chunk, genericClient client.Client, streamWG *sync.WaitGroup, logger logging.Logger, ctx context.Context, cancel context.CancelFunc) {
+	for {
+		select {
+		case input, ok := <-userInput:
+			if !ok {
+				logger.Info("User input channel closed")
+				cancel()
+				return
+			}
+			if input == "exit" || input == "quit" {
+				fmt.Println("👋 Goodbye!")
+				cancel()
+				return
+			}
+
+			logger.Info("Sending message", logging.F("input", input))
+			if err := genericClient.SendMessageAsync(input); err != nil {
+				logger.Error("Send error", logging.F("error", err.Error()))
+				continue
+			}
+			fmt.Print("🤖 Assistant: ")
+
+			streamWG.Add(1)
+			go func() {
+				defer streamWG.Done()
+				genericClient.StartStreaming(aiChunks, ctx)
+			}()
+
+		case chunk, ok := <-aiChunks:
+			if !ok {
+				logger.Info("AI chunks channel closed")
+				cancel()
+				return
+			}
+
+			switch chunk.Kind {
+			case client.ChunkText:
+				fmt.Print(chunk.Text)
+			case client.ChunkDone:
+				fmt.Println()
+			case client.ChunkError:
+				logger.Error("Stream error", logging.F("error", chunk.Text))
+			}
+		}
+	}
+}
+
+// runGenericChat drives any non-realtime provider (anthropic, ollama)
+// through the generic client.Client interface, reusing the same
+// user-input and display goroutines as runConcurrentChat. There is no
+// long-lived AI-streaming goroutine: handleGenericDisplay starts one
+// StartStreaming call per sent message.
+func runGenericChat(genericClient client.Client, logger logging.Logger) {
+	if err := genericClient.Connect(); err != nil {
+		logger.Error("Connection error", logging.F("error", err.Error()))
+		os.Exit(1)
+	}
+	defer genericClient.Close()
+
+	userInput := make(chan string, 5)
+	aiChunks := make(chan client.Chunk, 50)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fmt.Println("🚀 Starting chat...")
+	fmt.Println("🤖 GPT Concurrent Chat")
+	fmt.Println("Type 'exit' or 'quit' to end")
+	fmt.Println("────────────────────────────")
+
+	var wg sync.WaitGroup
+	var streamWG sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		handleUserInput(userInput, ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		handleGenericDisplay(userInput, aiChunks, genericClient, &streamWG, logger, ctx, cancel)
+	}()
+
+	<-ctx.Done()
+	wg.Wait()
+	streamWG.Wait()
+
+	close(userInput)
+	close(aiChunks)
+
+	fmt.Println("🔚 Chat ended")
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"go-gpt-cli/internal/client/openairealtime"
+	"go-gpt-cli/internal/config"
+	"go-gpt-cli/internal/gateway"
+	"go-gpt-cli/internal/logging"
+
+	"github.com/spf13/cobra"
+)
+
+var serveAddr string
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Expose the Realtime client as a local streaming service (see /ws)",
+	Run:   runServe,
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveAddr, "addr", ":8080", "Address to listen on")
+	rootCmd.AddCommand(serveCmd)
+}
+
+func runServe(cmd *cobra.Command, args []string) {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal("Config error:", err)
+	}
+
+	logLevel := logging.InfoLevel
+	if cfg.Debug {
+		logLevel = logging.DebugLevel
+	}
+	logger := logging.NewConsole(logLevel)
+
+	newClient := func() (*openairealtime.Client, error) {
+		return openairealtime.New(cfg.OpenAIAPIKey, cfg.WebSocketURL, cfg.Model,
+			openairealtime.WithLogger(logger),
+			openairealtime.WithReconnect(true),
+		), nil
+	}
+
+	server := gateway.NewServer(newClient, logger)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ws", server.HandleWS)
+
+	logger.Info("Serving chat gateway", logging.F("addr", serveAddr), logging.F("path", "/ws"))
+	if err := http.ListenAndServe(serveAddr, mux); err != nil {
+		logger.Error("Gateway server stopped", logging.F("error", err.Error()))
+	}
+}
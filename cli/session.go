@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"go-gpt-cli/internal/config"
+	"go-gpt-cli/internal/session"
+
+	"github.com/spf13/cobra"
+)
+
+var sessionCmd = &cobra.Command{
+	Use:   "session",
+	Short: "Manage persisted conversation sessions",
+}
+
+var sessionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved sessions, most recently updated first",
+	Run:   runSessionList,
+}
+
+var sessionNewCmd = &cobra.Command{
+	Use:   "new",
+	Short: "Create a new empty session and print its ID",
+	Run:   runSessionNew,
+}
+
+var sessionResumeCmd = &cobra.Command{
+	Use:   "resume <id>",
+	Short: "Print a session's recorded turns",
+	Args:  cobra.ExactArgs(1),
+	Run:   runSessionResume,
+}
+
+var sessionDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a saved session",
+	Args:  cobra.ExactArgs(1),
+	Run:   runSessionDelete,
+}
+
+func init() {
+	sessionCmd.AddCommand(sessionListCmd, sessionNewCmd, sessionResumeCmd, sessionDeleteCmd)
+	rootCmd.AddCommand(sessionCmd)
+}
+
+func openSessionStore() *session.FileStore {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal("Config error:", err)
+	}
+
+	store, err := session.NewFileStore(cfg.SessionDir)
+	if err != nil {
+		log.Fatal("Session store error:", err)
+	}
+	return store
+}
+
+func runSessionList(cmd *cobra.Command, args []string) {
+	store := openSessionStore()
+
+	ids, err := store.List()
+	if err != nil {
+		log.Fatal("Session list error:", err)
+	}
+
+	if len(ids) == 0 {
+		fmt.Println("No saved sessions")
+		return
+	}
+
+	for _, id := range ids {
+		fmt.Println(id)
+	}
+}
+
+func runSessionNew(cmd *cobra.Command, args []string) {
+	store := openSessionStore()
+
+	sess, err := store.New()
+	if err != nil {
+		log.Fatal("Session create error:", err)
+	}
+
+	fmt.Println(sess.ID)
+}
+
+func runSessionResume(cmd *cobra.Command, args []string) {
+	store := openSessionStore()
+
+	sess, err := store.Load(args[0])
+	if err != nil {
+		log.Fatal("Session resume error:", err)
+	}
+
+	fmt.Printf("📖 Session %s (%d turns)\n", sess.ID, len(sess.Items))
+	for _, item := range sess.Items {
+		fmt.Printf("[%s] %s: %s\n", item.SentAt.Format("15:04:05"), item.Role, item.Text)
+	}
+}
+
+func runSessionDelete(cmd *cobra.Command, args []string) {
+	store := openSessionStore()
+
+	if err := store.Delete(args[0]); err != nil {
+		log.Fatal("Session delete error:", err)
+	}
+
+	fmt.Printf("🗑️  Deleted session %s\n", args[0])
+}
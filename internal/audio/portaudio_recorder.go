@@ -0,0 +1,89 @@
+//go:build portaudio
+
+package audio
+
+import (
+	"encoding/binary"
+
+	"github.com/gordonklaus/portaudio"
+)
+
+// PortAudioRecorder captures microphone audio via PortAudio and emits PCM16
+// mono frames at SampleRate. It implements Recorder.
+type PortAudioRecorder struct {
+	SampleRate int
+	FrameSize  int
+
+	stream *portaudio.Stream
+	out    chan []byte
+	done   chan struct{}
+}
+
+// NewRecorder returns a recorder sampling at sampleRate, emitting frameSize
+// samples per chunk (defaults to 1024 if zero).
+func NewRecorder(sampleRate, frameSize int) Recorder {
+	if frameSize == 0 {
+		frameSize = 1024
+	}
+	return &PortAudioRecorder{SampleRate: sampleRate, FrameSize: frameSize}
+}
+
+func (r *PortAudioRecorder) Start() (<-chan []byte, error) {
+	if err := portaudio.Initialize(); err != nil {
+		return nil, err
+	}
+
+	in := make([]int16, r.FrameSize)
+	stream, err := portaudio.OpenDefaultStream(1, 0, float64(r.SampleRate), len(in), in)
+	if err != nil {
+		portaudio.Terminate()
+		return nil, err
+	}
+
+	if err := stream.Start(); err != nil {
+		stream.Close()
+		portaudio.Terminate()
+		return nil, err
+	}
+
+	r.stream = stream
+	r.out = make(chan []byte, 16)
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.out)
+		for {
+			select {
+			case <-r.done:
+				return
+			default:
+			}
+
+			if err := stream.Read(); err != nil {
+				return
+			}
+
+			pcm := make([]byte, len(in)*2)
+			for i, sample := range in {
+				binary.LittleEndian.PutUint16(pcm[i*2:], uint16(sample))
+			}
+			r.out <- pcm
+		}
+	}()
+
+	return r.out, nil
+}
+
+func (r *PortAudioRecorder) Stop() error {
+	if r.done != nil {
+		close(r.done)
+	}
+	if r.stream != nil {
+		defer portaudio.Terminate()
+		if err := r.stream.Stop(); err != nil {
+			return err
+		}
+		return r.stream.Close()
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+// Package audio provides microphone capture for the Realtime voice modality.
+package audio
+
+import "fmt"
+
+// Recorder captures raw PCM16 audio frames from a microphone (or any other
+// source) and streams them on a channel until Stop is called.
+type Recorder interface {
+	// Start begins capture and returns a channel of raw PCM16 mono frames
+	// sampled at the recorder's configured sample rate.
+	Start() (<-chan []byte, error)
+	// Stop ends capture and releases the underlying device.
+	Stop() error
+}
+
+// NopRecorder is a Recorder that never produces audio. It's useful in tests
+// and on builds where no microphone backend is available.
+type NopRecorder struct{}
+
+func (NopRecorder) Start() (<-chan []byte, error) {
+	ch := make(chan []byte)
+	close(ch)
+	return ch, nil
+}
+
+func (NopRecorder) Stop() error { return nil }
+
+// ErrNotSupported is returned by backends that can't capture audio on the
+// current platform/build.
+var ErrNotSupported = fmt.Errorf("audio: microphone capture not supported in this build")
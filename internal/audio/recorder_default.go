@@ -0,0 +1,10 @@
+//go:build !portaudio
+
+package audio
+
+// NewRecorder returns a Recorder for the current build. The default build
+// has no microphone backend linked in (portaudio requires cgo); build with
+// `-tags portaudio` to capture from a real microphone.
+func NewRecorder(sampleRate, frameSize int) Recorder {
+	return NopRecorder{}
+}
@@ -0,0 +1,207 @@
+// Package anthropic implements the Anthropic Messages API as a
+// go-gpt-cli/internal/client.Client backend, selected via --provider anthropic.
+package anthropic
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go-gpt-cli/internal/client"
+	"go-gpt-cli/internal/logging"
+)
+
+const defaultBaseURL = "https://api.anthropic.com/v1/messages"
+const anthropicVersion = "2023-06-01"
+
+// Client streams chat turns through the Anthropic Messages API using
+// server-sent events (stream: true), one HTTP request per turn since the
+// API is stateless across requests.
+type Client struct {
+	apiKey  string
+	baseURL string
+	model   string
+	logger  logging.Logger
+
+	mu       sync.Mutex
+	state    string
+	messages []message
+	http     *http.Client
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// Option configures a Client, following the functional-options pattern used
+// by internal/client/openairealtime.
+type Option func(*Client)
+
+// WithLogger overrides the Client's logger (defaults to a console logger at
+// info level).
+func WithLogger(logger logging.Logger) Option {
+	return func(c *Client) { c.logger = logger }
+}
+
+// WithBaseURL overrides the Messages API endpoint, e.g. for a proxy.
+func WithBaseURL(url string) Option {
+	return func(c *Client) { c.baseURL = url }
+}
+
+// New returns a Client ready to Connect. model is an Anthropic model name
+// such as "claude-3-5-sonnet-latest".
+func New(apiKey, model string, opts ...Option) *Client {
+	c := &Client{
+		apiKey:  apiKey,
+		baseURL: defaultBaseURL,
+		model:   model,
+		logger:  logging.NewConsole(logging.InfoLevel),
+		state:   "Idle",
+		http:    &http.Client{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Connect is a no-op: the Messages API is stateless HTTP, not a persistent
+// session. It exists to satisfy client.Client.
+func (c *Client) Connect() error {
+	c.logger.Info("Anthropic client ready", logging.F("model", c.model))
+	return nil
+}
+
+// Close is a no-op for the same reason Connect is.
+func (c *Client) Close() error { return nil }
+
+// CancelResponse is unsupported: the Messages API offers no server-side
+// cancel for an in-flight stream, so callers should close the context
+// passed to StartStreaming instead.
+func (c *Client) CancelResponse() error {
+	return fmt.Errorf("anthropic: cancel is not supported, cancel the StartStreaming context instead")
+}
+
+// GetState returns the last known conversation state as a human-readable
+// string (e.g. "Idle", "Responding", "Responded").
+func (c *Client) GetState() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state
+}
+
+func (c *Client) setState(s string) {
+	c.mu.Lock()
+	c.state = s
+	c.mu.Unlock()
+}
+
+// SendMessageAsync records the user's turn; the reply is streamed by the
+// next StartStreaming call, consistent with how openairealtime.Client
+// separates "send" from "stream the response".
+func (c *Client) SendMessageAsync(text string) error {
+	c.mu.Lock()
+	c.messages = append(c.messages, message{Role: "user", Content: text})
+	c.mu.Unlock()
+
+	c.setState("Responding")
+	return nil
+}
+
+// StartStreaming issues a request for the accumulated turn history and forwards each streamed
+// text delta as a Chunk until the response completes or ctx is done.
+func (c *Client) StartStreaming(chunks chan<- client.Chunk, ctx context.Context) {
+	c.mu.Lock()
+	pending := append([]message(nil), c.messages...)
+	c.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"model":      c.model,
+		"max_tokens": 4096,
+		"stream":     true,
+		"messages":   pending,
+	})
+	if err != nil {
+		chunks <- client.Chunk{Kind: client.ChunkError, Text: err.Error()}
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL, bytes.NewReader(body))
+	if err != nil {
+		chunks <- client.Chunk{Kind: client.ChunkError, Text: err.Error()}
+		return
+	}
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("x-api-key", c.apiKey)
+	req.Header.Set("anthropic-version", anthropicVersion)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		c.logger.Error("Anthropic request failed", logging.F("error", err.Error()))
+		chunks <- client.Chunk{Kind: client.ChunkError, Text: err.Error()}
+		c.setState("Idle")
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("anthropic: unexpected status %s", resp.Status)
+		chunks <- client.Chunk{Kind: client.ChunkError, Text: err.Error()}
+		c.setState("Idle")
+		return
+	}
+
+	var assistantText strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		payload := strings.TrimPrefix(line, "data: ")
+
+		var event struct {
+			Type  string `json:"type"`
+			Delta struct {
+				Text string `json:"text"`
+			} `json:"delta"`
+		}
+		if err := json.Unmarshal([]byte(payload), &event); err != nil {
+			continue
+		}
+
+		switch event.Type {
+		case "content_block_delta":
+			assistantText.WriteString(event.Delta.Text)
+			chunks <- client.Chunk{Kind: client.ChunkText, Text: event.Delta.Text}
+		case "message_stop":
+			c.mu.Lock()
+			c.messages = append(c.messages, message{Role: "assistant", Content: assistantText.String()})
+			c.mu.Unlock()
+			c.setState("Responded")
+			chunks <- client.Chunk{Kind: client.ChunkDone}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		c.logger.Error("Anthropic stream read error", logging.F("error", err.Error()))
+		chunks <- client.Chunk{Kind: client.ChunkError, Text: err.Error()}
+	}
+}
+
+var _ client.Client = (*Client)(nil)
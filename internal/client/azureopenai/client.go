@@ -0,0 +1,51 @@
+// Package azureopenai adapts internal/client/openairealtime to Azure
+// OpenAI's Realtime endpoint, which speaks the same WebSocket protocol
+// behind a different URL and auth header. Selected via --provider
+// azure-openai.
+package azureopenai
+
+import (
+	"fmt"
+	"net/url"
+
+	"go-gpt-cli/internal/client/openairealtime"
+)
+
+// Config holds the Azure-specific values needed to build a Realtime
+// WebSocket URL: an Azure OpenAI resource endpoint, a deployment name, and
+// an API version, in place of OpenAI's model-name + fixed-URL pair.
+type Config struct {
+	Endpoint   string // e.g. "my-resource.openai.azure.com"
+	Deployment string
+	APIVersion string // e.g. "2024-10-01-preview"
+	APIKey     string
+}
+
+// New returns an openairealtime.Client dialed against the given Azure
+// OpenAI deployment. opts are forwarded to openairealtime.New, so voice,
+// session, tools, reconnect and logging all work the same as the
+// OpenAI-direct provider.
+func New(cfg Config, opts ...openairealtime.Option) (*openairealtime.Client, error) {
+	if cfg.Endpoint == "" || cfg.Deployment == "" {
+		return nil, fmt.Errorf("azureopenai: endpoint and deployment are required")
+	}
+	apiVersion := cfg.APIVersion
+	if apiVersion == "" {
+		apiVersion = "2024-10-01-preview"
+	}
+
+	wsURL := url.URL{
+		Scheme: "wss",
+		Host:   cfg.Endpoint,
+		Path:   "/openai/realtime",
+		RawQuery: url.Values{
+			"api-version": {apiVersion},
+			"deployment":  {cfg.Deployment},
+		}.Encode(),
+	}
+
+	// openairealtime.Client dials with "Authorization: Bearer <apiKey>",
+	// which Azure's Realtime endpoint also accepts in place of its native
+	// "api-key" header, so no changes to dial() are needed here.
+	return openairealtime.New(cfg.APIKey, wsURL.String(), cfg.Deployment, opts...), nil
+}
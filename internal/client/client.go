@@ -0,0 +1,47 @@
+// Package client defines the provider-agnostic chat client contract.
+// internal/client/anthropic and internal/client/ollama satisfy it, so
+// cmd/chat drives them through one generic loop. The OpenAI Realtime
+// WebSocket client in internal/client/openairealtime (also returned by
+// internal/client/azureopenai) does not satisfy Client: it has its own
+// state type, string-based streaming and response options, and cmd/chat
+// drives it through a separate loop.
+package client
+
+import "context"
+
+// ChunkKind identifies what a streamed Chunk carries.
+type ChunkKind int
+
+const (
+	ChunkText        ChunkKind = iota // Text is an assistant reply fragment
+	ChunkStateChange                  // Text is the new state's name
+	ChunkDone                         // The current response has completed
+	ChunkError                        // Text is an error message
+)
+
+// Chunk is one normalized event streamed by a Client, letting
+// handleGenericDisplay render any backend's output the same way.
+type Chunk struct {
+	Kind ChunkKind
+	Text string
+}
+
+// Client is satisfied by every provider backend.
+type Client interface {
+	// Connect establishes the backend connection/session.
+	Connect() error
+	// Close releases the backend connection.
+	Close() error
+	// SendMessageAsync sends a user turn and requests a reply without
+	// waiting for it; StartStreaming delivers the reply asynchronously.
+	SendMessageAsync(text string) error
+	// CancelResponse interrupts the in-flight response, if any.
+	CancelResponse() error
+	// StartStreaming reads backend events and forwards normalized Chunks.
+	// It returns when ctx is done or, for request-per-turn backends such as
+	// anthropic and ollama, once the pending reply completes.
+	StartStreaming(chunks chan<- Chunk, ctx context.Context)
+	// GetState returns the backend's current conversation state, normalized
+	// to a human-readable name (e.g. "Idle", "Responding").
+	GetState() string
+}
@@ -0,0 +1,195 @@
+// Package ollama implements a local Ollama chat backend as a
+// go-gpt-cli/internal/client.Client, selected via --provider ollama.
+package ollama
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"go-gpt-cli/internal/client"
+	"go-gpt-cli/internal/logging"
+)
+
+const defaultBaseURL = "http://localhost:11434"
+
+// Client streams chat turns through Ollama's POST /api/chat endpoint, which
+// returns newline-delimited JSON objects rather than SSE.
+type Client struct {
+	baseURL string
+	model   string
+	logger  logging.Logger
+
+	mu       sync.Mutex
+	state    string
+	messages []message
+	http     *http.Client
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithLogger overrides the Client's logger (defaults to a console logger at
+// info level).
+func WithLogger(logger logging.Logger) Option {
+	return func(c *Client) { c.logger = logger }
+}
+
+// WithBaseURL overrides the Ollama server URL (defaults to
+// http://localhost:11434).
+func WithBaseURL(url string) Option {
+	return func(c *Client) { c.baseURL = url }
+}
+
+// New returns a Client ready to Connect. model is a locally pulled Ollama
+// model name such as "llama3".
+func New(model string, opts ...Option) *Client {
+	c := &Client{
+		baseURL: defaultBaseURL,
+		model:   model,
+		logger:  logging.NewConsole(logging.InfoLevel),
+		state:   "Idle",
+		http:    &http.Client{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Connect verifies the Ollama server is reachable.
+func (c *Client) Connect() error {
+	resp, err := c.http.Get(c.baseURL + "/api/tags")
+	if err != nil {
+		return fmt.Errorf("ollama: server unreachable at %s: %w", c.baseURL, err)
+	}
+	defer resp.Body.Close()
+	c.logger.Info("Connected to Ollama", logging.F("baseURL", c.baseURL), logging.F("model", c.model))
+	return nil
+}
+
+// Close is a no-op: each request opens its own HTTP connection.
+func (c *Client) Close() error { return nil }
+
+// CancelResponse is unsupported: Ollama's /api/chat has no server-side
+// cancel, so callers should close the context passed to StartStreaming
+// instead.
+func (c *Client) CancelResponse() error {
+	return fmt.Errorf("ollama: cancel is not supported, cancel the StartStreaming context instead")
+}
+
+// GetState returns the last known conversation state as a human-readable
+// string (e.g. "Idle", "Responding", "Responded").
+func (c *Client) GetState() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state
+}
+
+func (c *Client) setState(s string) {
+	c.mu.Lock()
+	c.state = s
+	c.mu.Unlock()
+}
+
+// SendMessageAsync records the user's turn; the reply is streamed by the
+// next StartStreaming call.
+func (c *Client) SendMessageAsync(text string) error {
+	c.mu.Lock()
+	c.messages = append(c.messages, message{Role: "user", Content: text})
+	c.mu.Unlock()
+
+	c.setState("Responding")
+	return nil
+}
+
+// StartStreaming issues a request for the accumulated turn history and
+// forwards each streamed text delta as a Chunk until the response
+// completes or ctx is done.
+func (c *Client) StartStreaming(chunks chan<- client.Chunk, ctx context.Context) {
+	c.mu.Lock()
+	pending := append([]message(nil), c.messages...)
+	c.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"model":    c.model,
+		"stream":   true,
+		"messages": pending,
+	})
+	if err != nil {
+		chunks <- client.Chunk{Kind: client.ChunkError, Text: err.Error()}
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/chat", bytes.NewReader(body))
+	if err != nil {
+		chunks <- client.Chunk{Kind: client.ChunkError, Text: err.Error()}
+		return
+	}
+	req.Header.Set("content-type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		c.logger.Error("Ollama request failed", logging.F("error", err.Error()))
+		chunks <- client.Chunk{Kind: client.ChunkError, Text: err.Error()}
+		c.setState("Idle")
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("ollama: unexpected status %s", resp.Status)
+		chunks <- client.Chunk{Kind: client.ChunkError, Text: err.Error()}
+		c.setState("Idle")
+		return
+	}
+
+	var assistantText bytes.Buffer
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var chunk struct {
+			Message message `json:"message"`
+			Done    bool    `json:"done"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &chunk); err != nil {
+			continue
+		}
+
+		if chunk.Message.Content != "" {
+			assistantText.WriteString(chunk.Message.Content)
+			chunks <- client.Chunk{Kind: client.ChunkText, Text: chunk.Message.Content}
+		}
+		if chunk.Done {
+			c.mu.Lock()
+			c.messages = append(c.messages, message{Role: "assistant", Content: assistantText.String()})
+			c.mu.Unlock()
+			c.setState("Responded")
+			chunks <- client.Chunk{Kind: client.ChunkDone}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		c.logger.Error("Ollama stream read error", logging.F("error", err.Error()))
+		chunks <- client.Chunk{Kind: client.ChunkError, Text: err.Error()}
+	}
+}
+
+var _ client.Client = (*Client)(nil)
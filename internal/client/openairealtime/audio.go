@@ -0,0 +1,123 @@
+package openairealtime
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"go-gpt-cli/internal/logging"
+)
+
+// VADConfig controls server-side voice activity detection for the input
+// audio buffer, mirroring the Realtime API's "turn_detection" object.
+type VADConfig struct {
+	Enabled           bool
+	Threshold         float64
+	PrefixPaddingMs   int
+	SilenceDurationMs int
+}
+
+// EnableVAD stores the VAD configuration to be sent on the next
+// session.update (on Connect, or immediately if already connected).
+func (c *Client) EnableVAD(cfg VADConfig) error {
+	c.mu.Lock()
+	c.vad = cfg
+	c.mu.Unlock()
+
+	if !c.connected() {
+		return nil
+	}
+	return c.sendSessionUpdate()
+}
+
+// AppendInputAudio appends a chunk of raw PCM16 mono audio to the server-side
+// input audio buffer. Call CommitInputAudio once the user has finished
+// speaking (or rely on server VAD when enabled).
+func (c *Client) AppendInputAudio(pcm []byte) error {
+	message := map[string]any{
+		"type":  "input_audio_buffer.append",
+		"audio": base64.StdEncoding.EncodeToString(pcm),
+	}
+	if err := c.writeJSON(message); err != nil {
+		return fmt.Errorf("failed to append input audio: %w", err)
+	}
+	return nil
+}
+
+// CommitInputAudio commits the buffered input audio as a conversation item.
+// Not needed when server VAD is enabled, since the server commits on silence.
+func (c *Client) CommitInputAudio() error {
+	message := map[string]any{
+		"type": "input_audio_buffer.commit",
+	}
+	if err := c.writeJSON(message); err != nil {
+		return fmt.Errorf("failed to commit input audio: %w", err)
+	}
+	return nil
+}
+
+// sendSessionUpdate pushes the client's modalities, voice and VAD settings
+// to the server. Safe to call whenever the connection is live.
+func (c *Client) sendSessionUpdate() error {
+	c.mu.RLock()
+	vad := c.vad
+	voice := c.voice
+	modalities := c.modalities
+	toolRegistry := c.tools
+	c.mu.RUnlock()
+
+	session := map[string]any{
+		"modalities": modalities,
+	}
+	if voice != "" {
+		session["voice"] = voice
+	}
+
+	if vad.Enabled {
+		session["turn_detection"] = map[string]any{
+			"type":                "server_vad",
+			"threshold":           vad.Threshold,
+			"prefix_padding_ms":   vad.PrefixPaddingMs,
+			"silence_duration_ms": vad.SilenceDurationMs,
+		}
+	} else {
+		session["turn_detection"] = nil
+	}
+
+	if toolRegistry != nil && toolRegistry.Len() > 0 {
+		session["tools"] = toolRegistry.Schemas()
+		session["tool_choice"] = "auto"
+	}
+
+	message := map[string]any{
+		"type":    "session.update",
+		"session": session,
+	}
+	if err := c.writeJSON(message); err != nil {
+		return fmt.Errorf("failed to send session.update: %w", err)
+	}
+	return nil
+}
+
+// decodeAudioDelta base64-decodes a response.audio.delta payload into raw
+// PCM16 bytes and forwards it to the client's audio output, if configured.
+func (c *Client) decodeAudioDelta(b64 string) {
+	pcm, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		c.logger.Error("Failed to decode audio delta", logging.F("error", err.Error()))
+		return
+	}
+
+	c.mu.RLock()
+	out := c.audioOut
+	c.mu.RUnlock()
+
+	if out == nil {
+		return
+	}
+
+	select {
+	case out <- pcm:
+	default:
+		// Channel full, drop this frame rather than block streaming.
+	}
+}
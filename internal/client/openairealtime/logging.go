@@ -0,0 +1,11 @@
+package openairealtime
+
+import "go-gpt-cli/internal/logging"
+
+// WithLogger overrides the Client's logger. Defaults to a console logger at
+// Info level; pass logging.NewJSONStdout(level) for headless/CI use.
+func WithLogger(logger logging.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
@@ -0,0 +1,101 @@
+package openairealtime
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"go-gpt-cli/internal/logging"
+)
+
+// WithReconnect enables (or disables) automatic reconnect with exponential
+// backoff when a read in StartStreaming fails. A failed write is returned
+// to its caller and does not by itself start a redial. Disabled by default.
+func WithReconnect(enabled bool) Option {
+	return func(c *Client) {
+		c.reconnect = enabled
+	}
+}
+
+// WithBackoff configures the reconnect backoff schedule: starting at min,
+// doubling each attempt up to max, with maxAttempts giving up after that
+// many tries (0 means retry forever until ctx is cancelled).
+func WithBackoff(min, max time.Duration, maxAttempts int) Option {
+	return func(c *Client) {
+		c.backoffMin = min
+		c.backoffMax = max
+		c.maxAttempts = maxAttempts
+	}
+}
+
+// WithOnReconnect registers a callback invoked after a successful redial, so
+// callers (e.g. the chat command) can surface a "🔄 Reconnected" message.
+func WithOnReconnect(cb func()) Option {
+	return func(c *Client) {
+		c.onReconnect = cb
+	}
+}
+
+// reconnectLoop closes the stale connection and redials with exponential
+// backoff and jitter until it succeeds, maxAttempts is exhausted, or ctx is
+// cancelled. On success it replays the session.update and prior conversation
+// items so the server's state matches what the client believes happened.
+func (c *Client) reconnectLoop(ctx context.Context) error {
+	c.SetState(StateReconnecting)
+
+	c.closeConn()
+
+	backoff := c.backoffMin
+	for attempt := 1; c.maxAttempts == 0 || attempt <= c.maxAttempts; attempt++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		c.logger.Info("Reconnecting", logging.F("attempt", attempt))
+
+		conn, err := c.dial()
+		if err == nil {
+			c.setConn(conn)
+
+			if err := c.sendSessionUpdate(); err != nil {
+				c.logger.Warn("Reconnected but failed to resend session config", logging.F("error", err.Error()))
+			}
+			if err := c.replaySession(); err != nil {
+				c.logger.Warn("Reconnected but failed to replay session history", logging.F("error", err.Error()))
+			}
+
+			c.Reset()
+			c.logger.Info("Reconnected", logging.F("attempt", attempt))
+			if c.onReconnect != nil {
+				c.onReconnect()
+			}
+			return nil
+		}
+
+		c.logger.Warn("Reconnect attempt failed", logging.F("attempt", attempt), logging.F("error", err.Error()))
+
+		wait := withJitter(backoff)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		backoff *= 2
+		if backoff > c.backoffMax {
+			backoff = c.backoffMax
+		}
+	}
+
+	return fmt.Errorf("gave up reconnecting after %d attempts", c.maxAttempts)
+}
+
+// withJitter adds uniform random jitter of ±25% to d.
+func withJitter(d time.Duration) time.Duration {
+	jitter := float64(d) * 0.25
+	offset := (rand.Float64()*2 - 1) * jitter // in [-jitter, +jitter]
+	return d + time.Duration(offset)
+}
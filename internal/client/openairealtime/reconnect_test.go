@@ -0,0 +1,34 @@
+package openairealtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithJitterStaysWithinBounds(t *testing.T) {
+	d := 100 * time.Millisecond
+	min := d - d/4
+	max := d + d/4
+
+	for i := 0; i < 100; i++ {
+		got := withJitter(d)
+		if got < min || got > max {
+			t.Fatalf("withJitter(%v) = %v, want within [%v, %v]", d, got, min, max)
+		}
+	}
+}
+
+func TestWithBackoffOption(t *testing.T) {
+	c := New("key", "wss://example.invalid", "model",
+		WithBackoff(50*time.Millisecond, 2*time.Second, 5))
+
+	if c.backoffMin != 50*time.Millisecond {
+		t.Errorf("backoffMin = %v, want 50ms", c.backoffMin)
+	}
+	if c.backoffMax != 2*time.Second {
+		t.Errorf("backoffMax = %v, want 2s", c.backoffMax)
+	}
+	if c.maxAttempts != 5 {
+		t.Errorf("maxAttempts = %d, want 5", c.maxAttempts)
+	}
+}
@@ -0,0 +1,126 @@
+package openairealtime
+
+import (
+	"fmt"
+	"time"
+
+	"go-gpt-cli/internal/logging"
+	"go-gpt-cli/internal/session"
+)
+
+// Option customizes a Client at construction time.
+type Option func(*Client)
+
+// WithSessionStore attaches a session.Store and loads (or creates) the
+// session identified by sessionID, so prior turns can be replayed on
+// connect and new turns are persisted as they complete.
+func WithSessionStore(store session.Store, sessionID string) Option {
+	return func(c *Client) {
+		c.sessionStore = store
+
+		sess, err := store.Load(sessionID)
+		if err != nil {
+			c.logger.Warn("Could not load session, starting fresh",
+				logging.F("session.id", sessionID),
+				logging.F("error", err.Error()),
+			)
+			sess = &session.Session{ID: sessionID}
+		}
+		c.session = sess
+	}
+}
+
+// recordUserTurn appends a user message to the active session, if any, and
+// persists it immediately so it survives a crash before the reply arrives.
+//
+// SendMessageAsync (handleDisplay's goroutine) and the StartStreaming read
+// loop both mutate the session, so c.mu guards every read/write of
+// c.session's fields and c.assistantBuf below.
+func (c *Client) recordUserTurn(text string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.session == nil {
+		return
+	}
+	c.session.AppendUser(text, time.Now())
+	c.saveSessionLocked()
+}
+
+// appendAssistantDelta buffers a streamed assistant text delta for the
+// current turn; it's flushed to the session on response.done.
+func (c *Client) appendAssistantDelta(delta string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.session == nil {
+		return
+	}
+	c.assistantBuf.WriteString(delta)
+}
+
+// flushAssistantTurn persists the buffered assistant reply as one turn and
+// resets the buffer for the next response.
+func (c *Client) flushAssistantTurn() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.session == nil || c.assistantBuf.Len() == 0 {
+		return
+	}
+	c.session.AppendAssistant(c.assistantBuf.String(), time.Now())
+	c.assistantBuf.Reset()
+	c.saveSessionLocked()
+}
+
+// saveSessionLocked persists the session to the store. Callers must hold c.mu.
+func (c *Client) saveSessionLocked() {
+	if c.sessionStore == nil || c.session == nil {
+		return
+	}
+	if err := c.sessionStore.Save(c.session); err != nil {
+		c.logger.Warn("Failed to save session", logging.F("error", err.Error()))
+	}
+}
+
+// replaySession re-sends every recorded turn as a conversation.item.create
+// so the server's context matches what's on disk, e.g. after a reconnect.
+func (c *Client) replaySession() error {
+	c.mu.RLock()
+	if c.session == nil {
+		c.mu.RUnlock()
+		return nil
+	}
+	items := append([]session.Item(nil), c.session.Items...)
+	c.mu.RUnlock()
+
+	for _, item := range items {
+		role := item.Role
+		contentType := "input_text"
+		if role == "assistant" {
+			contentType = "text"
+		}
+
+		message := map[string]any{
+			"type": "conversation.item.create",
+			"item": map[string]any{
+				"type": "message",
+				"role": role,
+				"content": []map[string]any{
+					{"type": contentType, "text": item.Text},
+				},
+			},
+		}
+		if err := c.writeJSON(message); err != nil {
+			return fmt.Errorf("failed to replay session item: %w", err)
+		}
+	}
+	return nil
+}
+
+// SessionID returns the ID of the active session, or "" if none is attached.
+func (c *Client) SessionID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.session == nil {
+		return ""
+	}
+	return c.session.ID
+}
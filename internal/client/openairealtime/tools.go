@@ -0,0 +1,101 @@
+package openairealtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go-gpt-cli/internal/logging"
+	"go-gpt-cli/internal/tools"
+)
+
+// WithTools attaches a tool registry. Its schemas are advertised to the
+// server on connect, and matching response.function_call_arguments events
+// are dispatched to the registered tools.
+func WithTools(registry *tools.Registry) Option {
+	return func(c *Client) {
+		c.tools = registry
+		c.callArgs = make(map[string]*strings.Builder)
+		c.callNames = make(map[string]string)
+	}
+}
+
+// handleFunctionCallDelta accumulates a streamed function_call_arguments.delta chunk.
+func (c *Client) handleFunctionCallDelta(callID, name, delta string) {
+	if c.callArgs == nil {
+		c.callArgs = make(map[string]*strings.Builder)
+		c.callNames = make(map[string]string)
+	}
+
+	if _, ok := c.callArgs[callID]; !ok {
+		c.callArgs[callID] = &strings.Builder{}
+	}
+	if name != "" {
+		c.callNames[callID] = name
+	}
+	c.callArgs[callID].WriteString(delta)
+}
+
+// handleFunctionCallDone dispatches the fully-accumulated call to the
+// registered tool in a goroutine, then feeds the result back as a
+// conversation item followed by a new response.create.
+func (c *Client) handleFunctionCallDone(callID, name string, argsJSON string) {
+	if name == "" {
+		name = c.callNames[callID]
+	}
+	if argsJSON == "" {
+		if buf, ok := c.callArgs[callID]; ok {
+			argsJSON = buf.String()
+		}
+	}
+	delete(c.callArgs, callID)
+	delete(c.callNames, callID)
+
+	if c.tools == nil {
+		c.logger.Warn("Received function call but no tool registry is configured", logging.F("tool", name))
+		return
+	}
+
+	tool, ok := c.tools.Get(name)
+	if !ok {
+		c.sendToolResult(callID, "", fmt.Errorf("no tool registered with name %q", name))
+		return
+	}
+
+	c.SetState(StateToolRunning)
+	c.logger.Info("Running tool", logging.F("tool", name), logging.F("call_id", callID))
+
+	go func() {
+		result, err := tool.Invoke(context.Background(), argsJSON)
+		c.sendToolResult(callID, result, err)
+	}()
+}
+
+// sendToolResult writes the tool's output back as a function_call_output
+// conversation item and asks the server to continue generating a response.
+func (c *Client) sendToolResult(callID, result string, toolErr error) {
+	if toolErr != nil {
+		result = fmt.Sprintf(`{"error": %q}`, toolErr.Error())
+		c.logger.Error("Tool call failed", logging.F("call_id", callID), logging.F("error", toolErr.Error()))
+	} else {
+		c.logger.Info("Tool call completed", logging.F("call_id", callID))
+	}
+
+	output := map[string]any{
+		"type": "conversation.item.create",
+		"item": map[string]any{
+			"type":    "function_call_output",
+			"call_id": callID,
+			"output":  result,
+		},
+	}
+	if err := c.writeJSON(output); err != nil {
+		c.logger.Error("Failed to send tool result", logging.F("error", err.Error()))
+		return
+	}
+
+	c.SetState(StateResponding)
+	if err := c.writeJSON(map[string]any{"type": "response.create"}); err != nil {
+		c.logger.Error("Failed to request response after tool call", logging.F("error", err.Error()))
+	}
+}
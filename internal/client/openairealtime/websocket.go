@@ -0,0 +1,556 @@
+package openairealtime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"go-gpt-cli/internal/logging"
+	"go-gpt-cli/internal/session"
+	"go-gpt-cli/internal/tools"
+)
+
+// ConversationState represents the current state of the conversation
+type ConversationState int32
+
+const (
+	StateIdle         ConversationState = iota // 0 - Waiting for input
+	StateResponding                            // 1 - AI is currently responding
+	StateCancelling                            // 2 - Cancelling current response
+	StateResponded                             // 3 - Response completed successfully
+	StateToolRunning                           // 4 - A function/tool call is being invoked
+	StateReconnecting                          // 5 - Redialing after a dropped connection
+)
+
+// String returns human-readable state name
+func (s ConversationState) String() string {
+	switch s {
+	case StateIdle:
+		return "Idle"
+	case StateResponding:
+		return "Responding"
+	case StateCancelling:
+		return "Cancelling"
+	case StateResponded:
+		return "Responded"
+	case StateToolRunning:
+		return "ToolRunning"
+	case StateReconnecting:
+		return "Reconnecting"
+	default:
+		return "Unknown"
+	}
+}
+
+type Client struct {
+	conn   *websocket.Conn
+	connMu sync.Mutex // Protects conn: reconnectLoop swaps it while other goroutines write to it
+	apiKey string
+	wsURL  string
+	model  string
+
+	// ✅ Pure Mutex state management - clear and simple
+	mu    sync.RWMutex      // Protects state field
+	state ConversationState // Now using proper type directly!
+
+	// Voice modality settings, pushed to the server via session.update.
+	modalities []string
+	voice      string
+	vad        VADConfig
+	audioOut   chan<- []byte // optional sink for decoded response.audio.delta frames
+
+	// Conversation persistence, set via WithSessionStore.
+	sessionStore session.Store
+	session      *session.Session
+	assistantBuf strings.Builder // accumulates the current turn's assistant reply
+
+	// Tool/function calling, set via WithTools.
+	tools     *tools.Registry
+	callArgs  map[string]*strings.Builder // call_id -> accumulated arguments JSON
+	callNames map[string]string           // call_id -> function name
+
+	// Reconnect behavior, set via WithReconnect/WithBackoff.
+	reconnect   bool
+	backoffMin  time.Duration
+	backoffMax  time.Duration
+	maxAttempts int
+	onReconnect func()
+
+	// logger receives structured records for every WebSocket event; set via
+	// WithLogger, defaults to a console logger at Info level.
+	logger logging.Logger
+}
+
+// =====================================
+// State Management API - Clean & Simple
+// =====================================
+
+// GetState returns current conversation state (thread-safe)
+func (c *Client) GetState() ConversationState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state // ✅ No casting needed!
+}
+
+// SetState sets conversation state (thread-safe)
+func (c *Client) SetState(newState ConversationState) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.state = newState // ✅ Direct assignment!
+}
+
+// IsResponding returns true if AI is currently responding
+func (c *Client) IsResponding() bool {
+	return c.GetState() == StateResponding
+}
+
+// CanInterrupt returns true if we can send a new message (interrupt or start new)
+func (c *Client) CanInterrupt() bool {
+	state := c.GetState()
+	return state == StateIdle || state == StateResponding
+}
+
+// TryStartResponse attempts to transition from Idle to Responding
+// Returns true if successful, false if already responding
+func (c *Client) TryStartResponse() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state == StateIdle {
+		c.state = StateResponding
+		return true
+	}
+	return false
+}
+
+// TryCancel attempts to transition from Responding to Cancelling
+// Returns true if successful, false if not responding
+func (c *Client) TryCancel() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state == StateResponding {
+		c.state = StateCancelling
+		return true
+	}
+	return false
+}
+
+// Reset sets state back to Idle (for cleanup/reset)
+func (c *Client) Reset() {
+	c.SetState(StateIdle)
+}
+
+func New(apiKey, wsURL, model string, opts ...Option) *Client {
+	c := &Client{
+		apiKey:     apiKey,
+		wsURL:      wsURL,
+		model:      model,
+		state:      StateIdle, // ✅ Initialize with proper type
+		modalities: []string{"text"},
+		backoffMin: 100 * time.Millisecond,
+		backoffMax: 30 * time.Second,
+		logger:     logging.NewConsole(logging.InfoLevel),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// SetVoice sets the voice used for audio responses (e.g. "alloy") and adds
+// "audio" to the requested modalities, so the server actually speaks replies
+// instead of only transcribing them as text. Takes effect on the next
+// session.update / SendMessageAsync.
+func (c *Client) SetVoice(voice string) {
+	c.mu.Lock()
+	c.voice = voice
+	c.modalities = []string{"text", "audio"}
+	c.mu.Unlock()
+}
+
+// SetAudioOutput configures a channel that decoded response.audio.delta PCM16
+// frames are forwarded to. Pass nil to stop receiving audio.
+func (c *Client) SetAudioOutput(audioOut chan<- []byte) {
+	c.mu.Lock()
+	c.audioOut = audioOut
+	c.mu.Unlock()
+}
+
+// מחזיר: error אם החיבור נכשל, או nil אם הצליח
+func (c *Client) Connect() error {
+	conn, err := c.dial()
+	if err != nil {
+		return err
+	}
+
+	// שמירת החיבור ב-struct
+	c.setConn(conn)
+
+	c.logger.Info("Connected")
+
+	if err := c.sendSessionUpdate(); err != nil {
+		return err
+	}
+
+	if err := c.replaySession(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// dial opens a fresh WebSocket connection to the Realtime endpoint. It does
+// not touch c.conn or any session state, so it's safe to call again during
+// reconnect while the old connection is still referenced.
+func (c *Client) dial() (*websocket.Conn, error) {
+	u, err := url.Parse(c.wsURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid WebSocket URL: %w", err)
+	}
+
+	q := u.Query()          // מקבל Values struct (map[string][]string)
+	q.Set("model", c.model) // מוסיף ?model=gpt-4o-mini-realtime-preview
+	u.RawQuery = q.Encode() // הופך את ה-Values חזרה לstring ושם ב-URL
+
+	headers := http.Header{
+		"Authorization": []string{"Bearer " + c.apiKey},
+		"OpenAI-Beta":   []string{"realtime=v1"},
+	}
+
+	c.logger.Info("Connecting", logging.F("url", u.String()))
+
+	// Dial() מחזיר 3 ערכים: connection, HTTP response, error
+	conn, resp, err := websocket.DefaultDialer.Dial(u.String(), headers)
+	if err != nil {
+		// אם יש response, מוסיף את הstatus code לשגיאה
+		if resp != nil {
+			return nil, fmt.Errorf("WebSocket connection failed: %w (status: %s)", err, resp.Status)
+		}
+		return nil, fmt.Errorf("WebSocket connection failed: %w", err)
+	}
+
+	return conn, nil
+}
+
+func (c *Client) Close() error {
+	return c.closeConn()
+}
+
+// setConn installs the active connection. Called from Connect and from
+// reconnectLoop (on a different goroutine than the one reading/writing it),
+// so it goes through connMu rather than touching the field directly.
+func (c *Client) setConn(conn *websocket.Conn) {
+	c.connMu.Lock()
+	c.conn = conn
+	c.connMu.Unlock()
+}
+
+// closeConn closes the active connection, if any.
+func (c *Client) closeConn() error {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// connected reports whether a connection has been established yet.
+func (c *Client) connected() bool {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+	return c.conn != nil
+}
+
+// readJSON reads the next message off the active connection. Only
+// StartStreaming's single read loop calls this, but the connection pointer
+// itself can change under it during a reconnect, so the pointer is still
+// read under connMu; the blocking Read call itself happens outside the
+// lock so a reconnect isn't held up waiting for the next message.
+func (c *Client) readJSON(v any) error {
+	c.connMu.Lock()
+	conn := c.conn
+	c.connMu.Unlock()
+	if conn == nil {
+		return fmt.Errorf("not connected")
+	}
+	return conn.ReadJSON(v)
+}
+
+// writeJSON writes a message to the active connection. The lock is held
+// for the full call so concurrent writers (SendMessageAsync, CancelResponse,
+// tool-result dispatch) can't interleave writes on the same *websocket.Conn,
+// which gorilla/websocket does not support.
+func (c *Client) writeJSON(v any) error {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+	if c.conn == nil {
+		return fmt.Errorf("not connected")
+	}
+	return c.conn.WriteJSON(v)
+}
+
+// =====================================
+// Concurrent Streaming Implementation
+// =====================================
+
+// responseIDFromEvent extracts the "response.id" field from a Realtime
+// event, if present, for structured logging.
+func responseIDFromEvent(event map[string]any) string {
+	if responseObj, ok := event["response"].(map[string]interface{}); ok {
+		if id, ok := responseObj["id"].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+// StartStreaming reads WebSocket messages concurrently and sends chunks to channel
+// chunks: send-only channel for streaming text chunks
+// ctx: context whose cancellation signals when to stop
+func (c *Client) StartStreaming(chunks chan<- string, ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			c.Reset() // Clean state on shutdown
+			return
+		default:
+			var response map[string]any
+			err := c.readJSON(&response)
+			if err != nil {
+				c.logger.Error("WebSocket read failed", logging.F("error", err.Error()))
+
+				if c.reconnect {
+					if reconnectErr := c.reconnectLoop(ctx); reconnectErr == nil {
+						continue // Resume reading on the newly-dialed connection
+					}
+				}
+
+				// Try to send error, but don't block if channel is full or ctx is cancelled
+				select {
+				case chunks <- fmt.Sprintf("\n❌ Connection error: %v\n", err):
+					// Error message sent successfully
+				case <-ctx.Done():
+					// Context cancelled, skip sending error message
+				default:
+					// Channel full, skip sending error message
+				}
+				c.Reset() // Reset state on error
+				return
+			}
+
+			// Process different message types from OpenAI
+			if msgType, ok := response["type"].(string); ok {
+				responseID := responseIDFromEvent(response)
+
+				switch msgType {
+				case "response.created":
+					// AI started generating a response
+					c.logger.Info("AI started responding",
+						logging.F("event.type", msgType),
+						logging.F("state", c.GetState().String()),
+						logging.F("response.id", responseID),
+					)
+
+				case "response.text.delta":
+					// Only process deltas if we're actually responding
+					if c.GetState() == StateResponding {
+						if delta, ok := response["delta"].(string); ok {
+							c.appendAssistantDelta(delta)
+
+							// Safe send to chunks channel
+							select {
+							case chunks <- delta:
+								// Delta sent successfully
+							case <-ctx.Done():
+								// Context cancelled, stop streaming
+								return
+							default:
+								// Channel full, skip this delta
+							}
+						}
+					}
+
+				case "response.done":
+					// Check the status of the response.done event
+					status := "unknown"
+					if responseObj, ok := response["response"].(map[string]interface{}); ok {
+						if statusVal, ok := responseObj["status"].(string); ok {
+							status = statusVal
+						}
+					}
+
+					// Handle different response completion statuses
+					switch status {
+					case "completed":
+						// Response completed successfully - mark as responded
+						c.flushAssistantTurn()
+						c.SetState(StateResponded)
+						c.logger.Info("AI response completed successfully",
+							logging.F("event.type", msgType),
+							logging.F("state", c.GetState().String()),
+							logging.F("response.id", responseID),
+						)
+
+					case "cancelled":
+						// Response was cancelled - back to idle
+						c.SetState(StateIdle)
+						c.logger.Info("Response cancelled via response.done",
+							logging.F("event.type", msgType),
+							logging.F("state", c.GetState().String()),
+							logging.F("response.id", responseID),
+						)
+
+					default:
+						// Unknown status - log the full event and reset state safely
+						c.logger.Warn("Unknown response.done status",
+							logging.F("event.type", msgType),
+							logging.F("state", c.GetState().String()),
+							logging.F("response.id", responseID),
+							logging.F("status", status),
+							logging.F("raw", response),
+						)
+						c.SetState(StateResponded) //?
+					}
+
+				case "response.audio.delta":
+					// Only process deltas if we're actually responding
+					if c.GetState() == StateResponding {
+						if delta, ok := response["delta"].(string); ok {
+							c.decodeAudioDelta(delta)
+						}
+					}
+
+				case "response.cancelled": //?
+					// Response was cancelled via separate event - back to idle
+					c.SetState(StateResponded)
+					c.logger.Info("Response cancelled via response.cancelled event",
+						logging.F("event.type", msgType),
+						logging.F("state", c.GetState().String()),
+						logging.F("response.id", responseID),
+					)
+
+				case "response.function_call_arguments.delta":
+					callID, _ := response["call_id"].(string)
+					name, _ := response["name"].(string)
+					delta, _ := response["delta"].(string)
+					c.handleFunctionCallDelta(callID, name, delta)
+
+				case "response.function_call_arguments.done":
+					callID, _ := response["call_id"].(string)
+					name, _ := response["name"].(string)
+					argsJSON, _ := response["arguments"].(string)
+					c.handleFunctionCallDone(callID, name, argsJSON)
+
+				case "error":
+					// Log error and reset state
+					c.logger.Error("AI Error",
+						logging.F("event.type", msgType),
+						logging.F("state", c.GetState().String()),
+						logging.F("response.id", responseID),
+						logging.F("raw", response),
+					)
+					c.Reset()
+				}
+			}
+		}
+	}
+}
+
+// ResponseOptions controls how a requested response is generated.
+type ResponseOptions struct {
+	Modalities []string
+}
+
+// ResponseOption customizes ResponseOptions.
+type ResponseOption func(*ResponseOptions)
+
+// WithModalities overrides the response modalities (e.g. []string{"text", "audio"}).
+func WithModalities(modalities ...string) ResponseOption {
+	return func(o *ResponseOptions) {
+		o.Modalities = modalities
+	}
+}
+
+// SendMessageAsync sends message and requests response without waiting
+// Used for concurrent mode where StartStreaming() handles the response
+// text: user message to send to OpenAI
+func (c *Client) SendMessageAsync(text string, opts ...ResponseOption) error {
+
+	if !c.TryStartResponse() {
+		return fmt.Errorf("failed to start response, state is %s", c.GetState())
+	}
+
+	c.recordUserTurn(text)
+
+	// Build user message for OpenAI Realtime API
+	message := map[string]any{
+		"type": "conversation.item.create",
+		"item": map[string]any{
+			"type": "message",
+			"role": "user",
+			"content": []map[string]any{
+				{"type": "input_text", "text": text},
+			},
+		},
+	}
+
+	// Send user message to WebSocket
+	if err := c.writeJSON(message); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	c.mu.RLock()
+	options := ResponseOptions{Modalities: c.modalities}
+	c.mu.RUnlock()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Request AI response immediately
+	responseRequest := map[string]any{
+		"type": "response.create",
+		"response": map[string]any{
+			"modalities": options.Modalities,
+		},
+	}
+
+	if err := c.writeJSON(responseRequest); err != nil {
+		return fmt.Errorf("failed to request response: %w", err)
+	}
+
+	return nil
+	// Note: No waiting for response - StartStreaming() goroutine handles it
+}
+
+// =====================================
+// Response Management
+// =====================================
+
+// CancelResponse - מבטל תגובה נוכחית מה-AI
+func (c *Client) CancelResponse() error {
+	if !c.TryCancel() {
+		return fmt.Errorf("failed to cancel response, state is %s", c.GetState())
+	}
+
+	c.logger.Info("Cancelling current AI response")
+
+	message := map[string]any{
+		"type": "response.cancel",
+	}
+
+	err := c.writeJSON(message)
+	if err != nil {
+		return fmt.Errorf("failed to cancel response: %w", err)
+	}
+
+	c.logger.Info("Cancel request sent to OpenAI")
+	return nil
+}
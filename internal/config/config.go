@@ -4,6 +4,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
@@ -13,6 +15,36 @@ type Config struct {
 	Debug        bool
 	WebSocketURL string
 	Model        string
+
+	// Provider selects which internal/client backend 'chat' dials into.
+	// Defaults to "openai-realtime". See also the --provider flag.
+	Provider string
+
+	// Voice modality settings, used when chat is run with --voice.
+	Voice                string
+	SampleRate           int
+	VADEnabled           bool
+	VADThreshold         float64
+	VADPrefixPaddingMs   int
+	VADSilenceDurationMs int
+
+	// SessionDir is where conversation history is persisted by
+	// internal/session. Defaults to ~/.gpt-cli/sessions.
+	SessionDir string
+
+	// AnthropicAPIKey and AnthropicModel configure Provider "anthropic".
+	AnthropicAPIKey string
+	AnthropicModel  string
+
+	// OllamaBaseURL and OllamaModel configure Provider "ollama".
+	OllamaBaseURL string
+	OllamaModel   string
+
+	// AzureOpenAI* configure Provider "azure-openai".
+	AzureOpenAIEndpoint   string
+	AzureOpenAIDeployment string
+	AzureOpenAIAPIKey     string
+	AzureOpenAIAPIVersion string
 }
 
 func Load() (*Config, error) {
@@ -21,19 +53,68 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to load .env file: %w", err)
 	}
 
-	apiKey := os.Getenv("OPENAI_API_KEY")
-	if apiKey == "" {
-		return nil, fmt.Errorf("OPENAI_API_KEY is missing in .env file")
+	provider := os.Getenv("PROVIDER")
+	if provider == "" {
+		provider = "openai-realtime"
 	}
 
+	// Whether OPENAI_API_KEY is actually required depends on the final
+	// provider, which --provider can still override after Load returns (see
+	// cli.go). Leave that check to the caller once the provider is settled;
+	// just read whatever key is present here.
+	apiKey := os.Getenv("OPENAI_API_KEY")
+
 	debug := os.Getenv("DEBUG") == "true"
 	webSocketURL := os.Getenv("OPENAI_WEBSOCKET_URL")
 	model := os.Getenv("MODEL")
 
+	sampleRate, _ := strconv.Atoi(os.Getenv("VOICE_SAMPLE_RATE"))
+	if sampleRate == 0 {
+		sampleRate = 24000 // Realtime API's default PCM16 sample rate
+	}
+
+	vadThreshold, _ := strconv.ParseFloat(os.Getenv("VAD_THRESHOLD"), 64)
+	if vadThreshold == 0 {
+		vadThreshold = 0.5
+	}
+	vadPrefixPaddingMs, _ := strconv.Atoi(os.Getenv("VAD_PREFIX_PADDING_MS"))
+	if vadPrefixPaddingMs == 0 {
+		vadPrefixPaddingMs = 300
+	}
+	vadSilenceDurationMs, _ := strconv.Atoi(os.Getenv("VAD_SILENCE_DURATION_MS"))
+	if vadSilenceDurationMs == 0 {
+		vadSilenceDurationMs = 500
+	}
+
+	sessionDir := os.Getenv("SESSION_DIR")
+	if sessionDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			home = "."
+		}
+		sessionDir = filepath.Join(home, ".gpt-cli", "sessions")
+	}
+
 	return &Config{
-		OpenAIAPIKey: apiKey,
-		Debug:        debug,
-		WebSocketURL: webSocketURL,
-		Model:        model,
+		OpenAIAPIKey:          apiKey,
+		Debug:                 debug,
+		WebSocketURL:          webSocketURL,
+		Model:                 model,
+		Provider:              provider,
+		Voice:                 os.Getenv("VOICE"),
+		SampleRate:            sampleRate,
+		VADEnabled:            os.Getenv("VAD_ENABLED") == "true",
+		VADThreshold:          vadThreshold,
+		VADPrefixPaddingMs:    vadPrefixPaddingMs,
+		VADSilenceDurationMs:  vadSilenceDurationMs,
+		SessionDir:            sessionDir,
+		AnthropicAPIKey:       os.Getenv("ANTHROPIC_API_KEY"),
+		AnthropicModel:        os.Getenv("ANTHROPIC_MODEL"),
+		OllamaBaseURL:         os.Getenv("OLLAMA_BASE_URL"),
+		OllamaModel:           os.Getenv("OLLAMA_MODEL"),
+		AzureOpenAIEndpoint:   os.Getenv("AZURE_OPENAI_ENDPOINT"),
+		AzureOpenAIDeployment: os.Getenv("AZURE_OPENAI_DEPLOYMENT"),
+		AzureOpenAIAPIKey:     os.Getenv("AZURE_OPENAI_API_KEY"),
+		AzureOpenAIAPIVersion: os.Getenv("AZURE_OPENAI_API_VERSION"),
 	}, nil
 }
@@ -0,0 +1,174 @@
+// Package gateway exposes a Client session as a network service, so a
+// browser or other-language client can drive a chat without holding an
+// OpenAI credential itself.
+//
+// DESCOPED: the original request asked for a gRPC server with a
+// grpc-gateway HTTP/WebSocket bridge. proto/chat.proto documents that
+// contract, but no protoc/protoc-gen-go/protoc-gen-go-grpc stubs are
+// generated and this package does not import google.golang.org/grpc or run
+// a grpc.Server anywhere. What's implemented instead is the /ws handler
+// below, a hand-rolled WebSocket-JSON bridge that covers the same
+// ChatRequest/ChatChunk exchange without needing generated stubs. Treat
+// this as a partial delivery: swap it for a real grpc.Server plus
+// grpc-gateway mux built from the generated stubs to close the original
+// request.
+//
+// TODO(chunk0-6 follow-up, not yet filed as its own request): do not treat
+// the request behind this package as closed until that swap happens. Once
+// protoc/protoc-gen-go/protoc-gen-go-grpc are available in this repo's build
+// environment, generate the stubs from proto/chat.proto, stand up a
+// grpc.Server implementing them, and front it with a grpc-gateway mux; this
+// file's Server/HandleWS can then either be deleted or kept as a thin
+// compatibility shim for existing WebSocket clients.
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+
+	"go-gpt-cli/internal/client/openairealtime"
+	"go-gpt-cli/internal/logging"
+)
+
+// WireRequest is the JSON form of proto.ChatRequest.
+type WireRequest struct {
+	Message string `json:"message,omitempty"`
+	Cancel  bool   `json:"cancel,omitempty"`
+}
+
+// WireChunk is the JSON form of proto.ChatChunk. Exactly one field is set,
+// mirroring the oneof in chat.proto.
+type WireChunk struct {
+	TextDelta   string `json:"text_delta,omitempty"`
+	StateChange string `json:"state_change,omitempty"`
+	Done        bool   `json:"done,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Server bridges WebSocket connections to Client sessions. NewClient is
+// called once per connection so each browser tab gets its own session.
+type Server struct {
+	NewClient func() (*openairealtime.Client, error)
+	Logger    logging.Logger
+	upgrader  websocket.Upgrader
+}
+
+// NewServer returns a Server that dials a fresh Client via newClient for
+// every /ws connection.
+func NewServer(newClient func() (*openairealtime.Client, error), logger logging.Logger) *Server {
+	return &Server{
+		NewClient: newClient,
+		Logger:    logger,
+		upgrader:  websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }},
+	}
+}
+
+// HandleWS upgrades the request to a WebSocket and proxies ChatRequest/
+// ChatChunk JSON frames to a dedicated Client session until the socket
+// closes, reusing the same user-input / AI-streaming / display fan-in/
+// fan-out pattern cmd/chat drives over stdin/stdout.
+func (s *Server) HandleWS(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.Logger.Error("Failed to upgrade /ws connection", logging.F("error", err.Error()))
+		return
+	}
+	defer conn.Close()
+
+	wsClient, err := s.NewClient()
+	if err != nil {
+		s.Logger.Error("Failed to create chat client", logging.F("error", err.Error()))
+		conn.WriteJSON(WireChunk{Error: err.Error()})
+		return
+	}
+	defer wsClient.Close()
+
+	if err := wsClient.Connect(); err != nil {
+		s.Logger.Error("Failed to connect chat client", logging.F("error", err.Error()))
+		conn.WriteJSON(WireChunk{Error: err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userInput := make(chan string, 5)
+	aiChunks := make(chan string, 50)
+
+	go s.readRequests(conn, wsClient, userInput, cancel, ctx)
+	go wsClient.StartStreaming(aiChunks, ctx)
+	s.writeChunks(conn, userInput, aiChunks, wsClient, cancel, ctx)
+}
+
+// readRequests is the fan-in side: it decodes WireRequest frames off the
+// WebSocket, acts on cancel requests directly against wsClient, and
+// forwards user messages to userInput.
+func (s *Server) readRequests(conn *websocket.Conn, wsClient *openairealtime.Client, userInput chan<- string, cancel context.CancelFunc, ctx context.Context) {
+	defer close(userInput)
+
+	for {
+		var req WireRequest
+		if err := conn.ReadJSON(&req); err != nil {
+			cancel()
+			return
+		}
+
+		if req.Cancel {
+			wsClient.CancelResponse()
+			continue
+		}
+		if req.Message == "" {
+			continue
+		}
+
+		select {
+		case userInput <- req.Message:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// writeChunks is the fan-out side: it turns user input and streamed AI
+// chunks into WireChunk frames written back over the WebSocket.
+func (s *Server) writeChunks(conn *websocket.Conn, userInput <-chan string, aiChunks <-chan string, wsClient *openairealtime.Client, cancel context.CancelFunc, ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case input, ok := <-userInput:
+			if !ok {
+				cancel()
+				return
+			}
+
+			if wsClient.GetState() == openairealtime.StateResponding {
+				wsClient.CancelResponse()
+			}
+
+			if err := wsClient.SendMessageAsync(input); err != nil {
+				conn.WriteJSON(WireChunk{Error: err.Error()})
+				continue
+			}
+			conn.WriteJSON(WireChunk{StateChange: wsClient.GetState().String()})
+
+		case chunk, ok := <-aiChunks:
+			if !ok {
+				cancel()
+				return
+			}
+
+			if conn.WriteJSON(WireChunk{TextDelta: chunk}) != nil {
+				cancel()
+				return
+			}
+
+			if wsClient.GetState() == openairealtime.StateResponded {
+				conn.WriteJSON(WireChunk{Done: true})
+			}
+		}
+	}
+}
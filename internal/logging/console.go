@@ -0,0 +1,59 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ConsoleLogger writes colored, human-readable lines to a TTY. It's the
+// default logger for interactive `chat` sessions.
+type ConsoleLogger struct {
+	mu    sync.Mutex
+	out   io.Writer
+	level Level
+}
+
+// NewConsole returns a ConsoleLogger writing to stdout at the given level.
+func NewConsole(level Level) *ConsoleLogger {
+	return &ConsoleLogger{out: os.Stdout, level: level}
+}
+
+var consoleColors = map[Level]string{
+	DebugLevel: "\033[90m", // gray
+	InfoLevel:  "\033[36m", // cyan
+	WarnLevel:  "\033[33m", // yellow
+	ErrorLevel: "\033[31m", // red
+}
+
+const consoleColorReset = "\033[0m"
+
+func (l *ConsoleLogger) log(level Level, msg string, fields []Field) {
+	if level < l.level {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var b strings.Builder
+	b.WriteString(consoleColors[level])
+	b.WriteString("[")
+	b.WriteString(level.String())
+	b.WriteString("] ")
+	b.WriteString(consoleColorReset)
+	b.WriteString(msg)
+
+	for _, f := range fields {
+		fmt.Fprintf(&b, " %s=%v", f.Key, f.Value)
+	}
+
+	fmt.Fprintln(l.out, b.String())
+}
+
+func (l *ConsoleLogger) Debug(msg string, fields ...Field) { l.log(DebugLevel, msg, fields) }
+func (l *ConsoleLogger) Info(msg string, fields ...Field)  { l.log(InfoLevel, msg, fields) }
+func (l *ConsoleLogger) Warn(msg string, fields ...Field)  { l.log(WarnLevel, msg, fields) }
+func (l *ConsoleLogger) Error(msg string, fields ...Field) { l.log(ErrorLevel, msg, fields) }
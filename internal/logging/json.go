@@ -0,0 +1,56 @@
+package logging
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// JSONLogger writes one JSON object per line, suitable for piping to files
+// or a log aggregator.
+type JSONLogger struct {
+	mu    sync.Mutex
+	out   io.Writer
+	level Level
+}
+
+// NewJSON returns a JSONLogger writing to w at the given level.
+func NewJSON(w io.Writer, level Level) *JSONLogger {
+	return &JSONLogger{out: w, level: level}
+}
+
+// NewJSONStdout returns a JSONLogger writing to stdout at the given level.
+func NewJSONStdout(level Level) *JSONLogger {
+	return NewJSON(os.Stdout, level)
+}
+
+func (l *JSONLogger) log(level Level, msg string, fields []Field) {
+	if level < l.level {
+		return
+	}
+
+	record := map[string]any{
+		"time":  time.Now().Format(time.RFC3339Nano),
+		"level": level.String(),
+		"msg":   msg,
+	}
+	for _, f := range fields {
+		record[f.Key] = f.Value
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	enc := json.NewEncoder(l.out)
+	if err := enc.Encode(record); err != nil {
+		// Best effort - logging must never panic the caller.
+		return
+	}
+}
+
+func (l *JSONLogger) Debug(msg string, fields ...Field) { l.log(DebugLevel, msg, fields) }
+func (l *JSONLogger) Info(msg string, fields ...Field)  { l.log(InfoLevel, msg, fields) }
+func (l *JSONLogger) Warn(msg string, fields ...Field)  { l.log(WarnLevel, msg, fields) }
+func (l *JSONLogger) Error(msg string, fields ...Field) { l.log(ErrorLevel, msg, fields) }
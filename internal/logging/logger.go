@@ -0,0 +1,51 @@
+// Package logging provides a small structured, leveled logging interface so
+// the CLI can run headlessly (scripts, CI) without losing the state-machine
+// visibility the console experience relies on.
+package logging
+
+// Level controls which log calls are emitted.
+type Level int
+
+const (
+	DebugLevel Level = iota
+	InfoLevel
+	WarnLevel
+	ErrorLevel
+)
+
+// String returns the level's short name, e.g. "INFO".
+func (l Level) String() string {
+	switch l {
+	case DebugLevel:
+		return "DEBUG"
+	case InfoLevel:
+		return "INFO"
+	case WarnLevel:
+		return "WARN"
+	case ErrorLevel:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Field is a structured key-value pair attached to a log record.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// F is a shorthand constructor for Field.
+func F(key string, value any) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Logger emits leveled, structured log records. Implementations must be
+// safe for concurrent use, since openairealtime.Client calls it from
+// multiple goroutines (streaming, tool dispatch, reconnect).
+type Logger interface {
+	Debug(msg string, fields ...Field)
+	Info(msg string, fields ...Field)
+	Warn(msg string, fields ...Field)
+	Error(msg string, fields ...Field)
+}
@@ -0,0 +1,116 @@
+package session
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FileStore persists each session as its own JSON file under Dir.
+type FileStore struct {
+	Dir string
+}
+
+// NewFileStore returns a FileStore rooted at dir, creating it if needed.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create session store dir: %w", err)
+	}
+	return &FileStore{Dir: dir}, nil
+}
+
+func (f *FileStore) path(id string) string {
+	return filepath.Join(f.Dir, id+".json")
+}
+
+func (f *FileStore) New() (*Session, error) {
+	now := time.Now()
+	s := &Session{ID: newSessionID(now), CreatedAt: now, UpdatedAt: now}
+	if err := f.Save(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (f *FileStore) Load(id string) (*Session, error) {
+	data, err := os.ReadFile(f.path(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("session: no session with id %q", id)
+		}
+		return nil, fmt.Errorf("failed to read session %q: %w", id, err)
+	}
+
+	var s Session
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse session %q: %w", id, err)
+	}
+	return &s, nil
+}
+
+func (f *FileStore) Save(s *Session) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize session %q: %w", s.ID, err)
+	}
+	if err := os.WriteFile(f.path(s.ID), data, 0o644); err != nil {
+		return fmt.Errorf("failed to write session %q: %w", s.ID, err)
+	}
+	return nil
+}
+
+func (f *FileStore) List() ([]string, error) {
+	entries, err := os.ReadDir(f.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	type idAndTime struct {
+		id      string
+		updated int64
+	}
+	var all []idAndTime
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		id := strings.TrimSuffix(e.Name(), ".json")
+		s, err := f.Load(id)
+		if err != nil {
+			continue
+		}
+		all = append(all, idAndTime{id: id, updated: s.UpdatedAt.Unix()})
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i].updated > all[j].updated })
+
+	ids := make([]string, len(all))
+	for i, e := range all {
+		ids[i] = e.id
+	}
+	return ids, nil
+}
+
+func (f *FileStore) Delete(id string) error {
+	if err := os.Remove(f.path(id)); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("session: no session with id %q", id)
+		}
+		return fmt.Errorf("failed to delete session %q: %w", id, err)
+	}
+	return nil
+}
+
+// newSessionID generates a short, sortable-enough session ID combining the
+// creation time with a few random bytes to avoid collisions.
+func newSessionID(at time.Time) string {
+	buf := make([]byte, 4)
+	_, _ = rand.Read(buf)
+	return fmt.Sprintf("%s-%s", at.Format("20060102T150405"), hex.EncodeToString(buf))
+}
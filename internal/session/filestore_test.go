@@ -0,0 +1,94 @@
+package session
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFileStoreSaveLoadRoundTrip(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+
+	s, err := store.New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	s.AppendUser("hello", time.Now())
+	s.AppendAssistant("hi there", time.Now())
+	if err := store.Save(s); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := store.Load(s.ID)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(loaded.Items) != 2 {
+		t.Fatalf("Load() got %d items, want 2", len(loaded.Items))
+	}
+	if loaded.Items[0].Role != "user" || loaded.Items[0].Text != "hello" {
+		t.Errorf("Load() item[0] = %+v, want user/hello", loaded.Items[0])
+	}
+	if loaded.Items[1].Role != "assistant" || loaded.Items[1].Text != "hi there" {
+		t.Errorf("Load() item[1] = %+v, want assistant/hi there", loaded.Items[1])
+	}
+}
+
+func TestFileStoreLoadMissing(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+
+	if _, err := store.Load("does-not-exist"); err == nil {
+		t.Fatal("Load() on a missing session returned nil error, want one")
+	}
+}
+
+func TestFileStoreListMostRecentFirst(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+
+	older := &Session{ID: "older", UpdatedAt: time.Unix(100, 0)}
+	newer := &Session{ID: "newer", UpdatedAt: time.Unix(200, 0)}
+	if err := store.Save(older); err != nil {
+		t.Fatalf("Save(older) error = %v", err)
+	}
+	if err := store.Save(newer); err != nil {
+		t.Fatalf("Save(newer) error = %v", err)
+	}
+
+	ids, err := store.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "newer" || ids[1] != "older" {
+		t.Fatalf("List() = %v, want [newer older]", ids)
+	}
+}
+
+func TestFileStoreDelete(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+
+	s, err := store.New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := store.Delete(s.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Load(s.ID); err == nil {
+		t.Fatal("Load() after Delete() returned nil error, want one")
+	}
+	if err := store.Delete(s.ID); err == nil {
+		t.Fatal("Delete() on an already-deleted session returned nil error, want one")
+	}
+}
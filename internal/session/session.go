@@ -0,0 +1,50 @@
+// Package session persists conversation history to disk so a chat can be
+// resumed after a restart or reviewed offline.
+package session
+
+import (
+	"time"
+)
+
+// Item mirrors a single Realtime conversation.item.create payload: a user
+// message or an assembled assistant reply.
+type Item struct {
+	Role   string    `json:"role"` // "user" or "assistant"
+	Text   string    `json:"text"`
+	SentAt time.Time `json:"sent_at"`
+}
+
+// Session is a single conversation's full history.
+type Session struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Items     []Item    `json:"items"`
+}
+
+// AppendUser records a user turn.
+func (s *Session) AppendUser(text string, at time.Time) {
+	s.Items = append(s.Items, Item{Role: "user", Text: text, SentAt: at})
+	s.UpdatedAt = at
+}
+
+// AppendAssistant records an assembled assistant reply.
+func (s *Session) AppendAssistant(text string, at time.Time) {
+	s.Items = append(s.Items, Item{Role: "assistant", Text: text, SentAt: at})
+	s.UpdatedAt = at
+}
+
+// Store persists and retrieves Sessions. FileStore is the default
+// implementation; a SQLite-backed Store can satisfy the same interface.
+type Store interface {
+	// New creates and persists an empty session, returning it.
+	New() (*Session, error)
+	// Load reads a session by ID.
+	Load(id string) (*Session, error)
+	// Save persists the full session (overwrites any existing record).
+	Save(s *Session) error
+	// List returns the IDs of all known sessions, most recent first.
+	List() ([]string, error)
+	// Delete removes a session by ID.
+	Delete(id string) error
+}
@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPGetTool lets the model fetch a URL over GET. Useful for letting the
+// assistant pull in live data during a conversation.
+type HTTPGetTool struct {
+	Client *http.Client
+}
+
+// NewHTTPGetTool returns an HTTPGetTool with a sane request timeout.
+func NewHTTPGetTool() *HTTPGetTool {
+	return &HTTPGetTool{Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (t *HTTPGetTool) Name() string { return "http_get" }
+
+func (t *HTTPGetTool) Description() string {
+	return "Fetch a URL via HTTP GET and return its status code and response body (truncated)."
+}
+
+func (t *HTTPGetTool) JSONSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"url": map[string]any{
+				"type":        "string",
+				"description": "The URL to fetch",
+			},
+		},
+		"required": []string{"url"},
+	}
+}
+
+type httpGetArgs struct {
+	URL string `json:"url"`
+}
+
+const maxHTTPGetBodyBytes = 16 * 1024
+
+func (t *HTTPGetTool) Invoke(ctx context.Context, argsJSON string) (string, error) {
+	var args httpGetArgs
+	if err := json.Unmarshal([]byte(argsJSON), &args); err != nil {
+		return "", fmt.Errorf("http_get: invalid arguments: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, args.URL, nil)
+	if err != nil {
+		return "", fmt.Errorf("http_get: invalid url: %w", err)
+	}
+
+	resp, err := t.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("http_get: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPGetBodyBytes))
+	if err != nil {
+		return "", fmt.Errorf("http_get: failed to read response: %w", err)
+	}
+
+	result, err := json.Marshal(map[string]any{
+		"status": resp.StatusCode,
+		"body":   string(body),
+	})
+	if err != nil {
+		return "", fmt.Errorf("http_get: failed to encode result: %w", err)
+	}
+
+	return string(result), nil
+}
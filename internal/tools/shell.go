@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+)
+
+// ShellTool runs a fixed, allowlisted set of shell commands. The model may
+// only invoke commands named in Allowed; anything else is refused.
+type ShellTool struct {
+	Allowed []string
+}
+
+// NewShellTool returns a ShellTool restricted to the given command names
+// (e.g. "ls", "pwd", "date" — no arbitrary shell, no pipes).
+func NewShellTool(allowed ...string) *ShellTool {
+	return &ShellTool{Allowed: allowed}
+}
+
+func (t *ShellTool) Name() string { return "shell_exec" }
+
+func (t *ShellTool) Description() string {
+	return fmt.Sprintf("Run an allowlisted shell command with arguments. Allowed commands: %v", t.Allowed)
+}
+
+func (t *ShellTool) JSONSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"command": map[string]any{
+				"type":        "string",
+				"description": "The command to run, must be one of the allowed commands",
+			},
+			"args": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": "Arguments to pass to the command",
+			},
+		},
+		"required": []string{"command"},
+	}
+}
+
+type shellArgs struct {
+	Command string   `json:"command"`
+	Args    []string `json:"args"`
+}
+
+func (t *ShellTool) isAllowed(command string) bool {
+	for _, allowed := range t.Allowed {
+		if allowed == command {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *ShellTool) Invoke(ctx context.Context, argsJSON string) (string, error) {
+	var args shellArgs
+	if err := json.Unmarshal([]byte(argsJSON), &args); err != nil {
+		return "", fmt.Errorf("shell_exec: invalid arguments: %w", err)
+	}
+
+	if !t.isAllowed(args.Command) {
+		return "", fmt.Errorf("shell_exec: command %q is not in the allowlist", args.Command)
+	}
+
+	cmd := exec.CommandContext(ctx, args.Command, args.Args...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	runErr := cmd.Run()
+
+	result, err := json.Marshal(map[string]any{
+		"stdout": stdout.String(),
+		"stderr": stderr.String(),
+		"error":  errString(runErr),
+	})
+	if err != nil {
+		return "", fmt.Errorf("shell_exec: failed to encode result: %w", err)
+	}
+
+	return string(result), nil
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,60 @@
+// Package tools implements the Realtime API's function-calling protocol: a
+// pluggable registry of callable tools whose schemas are advertised to the
+// model and whose results are fed back as conversation items.
+package tools
+
+import "context"
+
+// Tool is a single callable function exposed to the model.
+type Tool interface {
+	// Name is the function name the model calls, e.g. "get_weather".
+	Name() string
+	// Description is shown to the model to help it decide when to call this tool.
+	Description() string
+	// JSONSchema describes the tool's arguments as a JSON Schema object.
+	JSONSchema() map[string]any
+	// Invoke runs the tool with the model-supplied arguments (raw JSON) and
+	// returns the result as JSON (or an error the model can see and react to).
+	Invoke(ctx context.Context, argsJSON string) (resultJSON string, err error)
+}
+
+// Registry holds the set of tools available to a Client for a given session.
+type Registry struct {
+	tools map[string]Tool
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{tools: make(map[string]Tool)}
+}
+
+// Register adds a tool, replacing any previously registered tool with the same name.
+func (r *Registry) Register(t Tool) {
+	r.tools[t.Name()] = t
+}
+
+// Get returns the tool registered under name, if any.
+func (r *Registry) Get(name string) (Tool, bool) {
+	t, ok := r.tools[name]
+	return t, ok
+}
+
+// Schemas returns the Realtime "tools" session.update payload for every
+// registered tool.
+func (r *Registry) Schemas() []map[string]any {
+	schemas := make([]map[string]any, 0, len(r.tools))
+	for _, t := range r.tools {
+		schemas = append(schemas, map[string]any{
+			"type":        "function",
+			"name":        t.Name(),
+			"description": t.Description(),
+			"parameters":  t.JSONSchema(),
+		})
+	}
+	return schemas
+}
+
+// Len returns the number of registered tools.
+func (r *Registry) Len() int {
+	return len(r.tools)
+}
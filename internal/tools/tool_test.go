@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"testing"
+)
+
+type fakeTool struct {
+	name string
+}
+
+func (f fakeTool) Name() string        { return f.name }
+func (f fakeTool) Description() string { return "a fake tool for tests" }
+func (f fakeTool) JSONSchema() map[string]any {
+	return map[string]any{"type": "object"}
+}
+func (f fakeTool) Invoke(ctx context.Context, argsJSON string) (string, error) {
+	return "ok", nil
+}
+
+func TestRegistryRegisterAndGet(t *testing.T) {
+	r := NewRegistry()
+	if r.Len() != 0 {
+		t.Fatalf("Len() on empty registry = %d, want 0", r.Len())
+	}
+
+	r.Register(fakeTool{name: "get_weather"})
+	if r.Len() != 1 {
+		t.Fatalf("Len() after Register = %d, want 1", r.Len())
+	}
+
+	got, ok := r.Get("get_weather")
+	if !ok || got.Name() != "get_weather" {
+		t.Fatalf("Get(%q) = %v, %v, want a tool named get_weather", "get_weather", got, ok)
+	}
+
+	if _, ok := r.Get("missing"); ok {
+		t.Fatal("Get() on an unregistered name returned ok = true, want false")
+	}
+}
+
+func TestRegistryRegisterReplacesSameName(t *testing.T) {
+	r := NewRegistry()
+	first := fakeTool{name: "get_weather"}
+	r.Register(first)
+	r.Register(fakeTool{name: "get_weather"})
+
+	if r.Len() != 1 {
+		t.Fatalf("Len() after re-registering the same name = %d, want 1", r.Len())
+	}
+}
+
+func TestRegistrySchemas(t *testing.T) {
+	r := NewRegistry()
+	r.Register(fakeTool{name: "get_weather"})
+	r.Register(fakeTool{name: "shell_exec"})
+
+	schemas := r.Schemas()
+	if len(schemas) != 2 {
+		t.Fatalf("Schemas() returned %d entries, want 2", len(schemas))
+	}
+
+	names := map[string]bool{}
+	for _, s := range schemas {
+		if s["type"] != "function" {
+			t.Errorf("schema type = %v, want %q", s["type"], "function")
+		}
+		name, _ := s["name"].(string)
+		names[name] = true
+	}
+	if !names["get_weather"] || !names["shell_exec"] {
+		t.Fatalf("Schemas() names = %v, want both get_weather and shell_exec", names)
+	}
+}